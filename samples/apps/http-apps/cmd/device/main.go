@@ -3,23 +3,50 @@ package main
 import (
 	"flag"
 	"fmt"
-	"log"
 	"math/rand"
 	"net"
 	"net/http"
 	"time"
 
 	"github.com/deislabs/akri/http-extensibility/shared"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 const (
 	addr = ":8080"
 )
 
+var (
+	metricsEndpoint = flag.String("metrics_endpoint", "", "If set, serve Prometheus metrics on this endpoint.")
+)
+
 var _ flag.Value = (*shared.RepeatableFlag)(nil)
 var paths shared.RepeatableFlag
 
+// handlerRequests is akri_device_handler_requests_total from the request:
+// how many times each path has been polled.
+var handlerRequests = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "akri_device_handler_requests_total",
+		Help: "Number of requests served by this device, by path.",
+	},
+	[]string{"path"},
+)
+
+func init() {
+	prometheus.MustRegister(handlerRequests)
+}
+
 func main() {
+	logger, err := shared.NewLogger()
+	if err != nil {
+		panic(err)
+	}
+	defer logger.Sync()
+	sugar := logger.Sugar()
+
 	flag.Var(&paths, "path", "Repeat this flag to add paths for the device")
 	flag.Parse()
 
@@ -27,7 +54,7 @@ func main() {
 	if len(paths) == 0 {
 		paths = []string{"/"}
 	}
-	log.Printf("[main] Paths: %d", len(paths))
+	sugar.Infof("[main] Paths: %d", len(paths))
 
 	seed := rand.NewSource(time.Now().UnixNano())
 	entr := rand.New(seed)
@@ -36,22 +63,33 @@ func main() {
 
 	// Create handler for each endpoint
 	for _, path := range paths {
-		log.Printf("[main] Creating handler: %s", path)
+		path := path
+		sugar.Infof("[main] Creating handler: %s", path)
 		handler.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
-			log.Printf("[main:handler] Handler entered: %s", path)
+			sugar.Infow("[main:handler] Handler entered", "path", path)
+			handlerRequests.WithLabelValues(path).Inc()
 			fmt.Fprint(w, entr.Float64())
 		})
 	}
 
+	if *metricsEndpoint != "" {
+		metricsHandler := http.NewServeMux()
+		metricsHandler.Handle("/metrics", promhttp.Handler())
+		go func() {
+			sugar.Infof("[main] Starting metrics server [%s]", *metricsEndpoint)
+			sugar.Fatal(http.ListenAndServe(*metricsEndpoint, metricsHandler))
+		}()
+	}
+
 	s := &http.Server{
 		Addr:    addr,
 		Handler: handler,
 	}
 	listen, err := net.Listen("tcp", addr)
 	if err != nil {
-		log.Fatal(err)
+		sugar.Fatal(err)
 	}
 
-	log.Printf("[main] Starting Device: [%s]", addr)
-	log.Fatal(s.Serve(listen))
+	sugar.Infof("[main] Starting Device: [%s]", addr)
+	sugar.Fatal(s.Serve(listen))
 }
@@ -7,6 +7,7 @@ import (
 	"log"
 	"net"
 	"net/http"
+	"net/url"
 
 	"github.com/deislabs/akri/http-extensibility/shared"
 )
@@ -15,6 +16,21 @@ const (
 	addr = ":9999"
 )
 
+// defaultDevices is a fake registration served when --fake_devices is set
+// and no --device flags are given, so the discovery service has something
+// to return out of the box. It deliberately spans schemes to show that a
+// single discovery handler can front http(s), coap, and modbus+tcp brokers
+// alike.
+var defaultDevices = shared.RepeatableFlag{
+	"http://device.default.svc.cluster.local:8080/",
+	"coap://device.default.svc.cluster.local:5683/",
+	"modbus+tcp://device.default.svc.cluster.local:502/1/40001",
+}
+
+var (
+	fakeDevices = flag.Bool("fake_devices", false, "When set and no --device flags are given, register defaultDevices instead of reporting no devices")
+)
+
 var _ flag.Value = (*shared.RepeatableFlag)(nil)
 var devices shared.RepeatableFlag
 
@@ -22,6 +38,13 @@ func main() {
 	flag.Var(&devices, "device", "Repeat this flag to add devices to the discovery service")
 	flag.Parse()
 
+	if len(devices) == 0 && *fakeDevices {
+		devices = defaultDevices
+	}
+	for _, device := range devices {
+		log.Printf("[main] Registered device: %s (%s)", device, deviceScheme(device))
+	}
+
 	handler := http.NewServeMux()
 	handler.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		log.Printf("[discovery] Handler entered")
@@ -40,3 +63,13 @@ func main() {
 	log.Printf("[createDiscoveryService] Starting Discovery Service: %s", addr)
 	log.Fatal(s.Serve(listen))
 }
+
+// deviceScheme returns the URL scheme of device, or "unknown" if it can't
+// be parsed as a URL.
+func deviceScheme(device string) string {
+	u, err := url.Parse(device)
+	if err != nil || u.Scheme == "" {
+		return "unknown"
+	}
+	return u.Scheme
+}
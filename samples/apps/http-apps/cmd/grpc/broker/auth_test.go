@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestLoadBrokerToken(t *testing.T) {
+	t.Run("env", func(t *testing.T) {
+		os.Setenv(brokerTokenEnv, "from-env")
+		defer os.Unsetenv(brokerTokenEnv)
+
+		got, err := loadBrokerToken("")
+		if err != nil {
+			t.Fatalf("loadBrokerToken: %v", err)
+		}
+		if got != "from-env" {
+			t.Errorf("got %q, want %q", got, "from-env")
+		}
+	})
+
+	t.Run("file takes precedence over env", func(t *testing.T) {
+		os.Setenv(brokerTokenEnv, "from-env")
+		defer os.Unsetenv(brokerTokenEnv)
+
+		path := filepath.Join(t.TempDir(), "token")
+		if err := ioutil.WriteFile(path, []byte("from-file\n"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := loadBrokerToken(path)
+		if err != nil {
+			t.Fatalf("loadBrokerToken: %v", err)
+		}
+		if got != "from-file" {
+			t.Errorf("got %q, want %q", got, "from-file")
+		}
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		if _, err := loadBrokerToken(filepath.Join(t.TempDir(), "missing")); err == nil {
+			t.Error("expected an error for a missing token file")
+		}
+	})
+}
+
+func TestCheckBrokerToken(t *testing.T) {
+	cases := []struct {
+		name    string
+		md      metadata.MD
+		wantErr bool
+	}{
+		{"valid bearer", metadata.Pairs("authorization", "Bearer secret"), false},
+		{"valid bare", metadata.Pairs("authorization", "secret"), false},
+		{"wrong token", metadata.Pairs("authorization", "Bearer nope"), true},
+		{"no metadata", nil, true},
+		{"no authorization header", metadata.Pairs("x-other", "secret"), true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tc.md)
+			}
+
+			err := checkBrokerToken(ctx, "secret")
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("checkBrokerToken() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if err != nil && status.Code(err) != codes.Unauthenticated {
+				t.Errorf("got code %v, want %v", status.Code(err), codes.Unauthenticated)
+			}
+		})
+	}
+}
+
+func TestLoopbackTokenCredentials(t *testing.T) {
+	c := loopbackTokenCredentials{token: "secret", requireTLS: true}
+
+	md, err := c.GetRequestMetadata(context.Background())
+	if err != nil {
+		t.Fatalf("GetRequestMetadata: %v", err)
+	}
+	if md["authorization"] != "Bearer secret" {
+		t.Errorf("got %q, want %q", md["authorization"], "Bearer secret")
+	}
+	if !c.RequireTransportSecurity() {
+		t.Error("RequireTransportSecurity() = false, want true when requireTLS is set")
+	}
+
+	c.requireTLS = false
+	if c.RequireTransportSecurity() {
+		t.Error("RequireTransportSecurity() = true, want false when requireTLS is unset")
+	}
+}
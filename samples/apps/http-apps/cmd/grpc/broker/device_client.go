@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/deislabs/akri/http-extensibility/shared"
+)
+
+// DeviceClient is implemented by each supported device protocol. Read
+// fetches path (a protocol-specific selector: an HTTP path, a CoAP
+// resource, or a Modbus register range) and returns the raw payload
+// together with its content type.
+type DeviceClient interface {
+	Read(ctx context.Context, path string) (body []byte, contentType string, err error)
+}
+
+// deviceClientOptions carries the http(s)-specific knobs that come from
+// broker flags; other schemes ignore it.
+type deviceClientOptions struct {
+	Method  string
+	Headers map[string]string
+	Accept  string
+}
+
+// newDeviceClient dispatches on rawURL's scheme to build the DeviceClient
+// the broker will poll for the lifetime of the process. Scheme dispatch
+// happens once here; ReadSensor and friends stay protocol-agnostic.
+func newDeviceClient(rawURL string, httpClient *http.Client, opts deviceClientOptions) (DeviceClient, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing device endpoint %q: %w", rawURL, err)
+	}
+
+	switch u.Scheme {
+	case "http", "https":
+		return newHTTPDeviceClient(rawURL, httpClient, opts), nil
+	case "coap":
+		return newCoAPDeviceClient(u)
+	case "modbus+tcp":
+		return newModbusDeviceClient(u)
+	default:
+		return nil, fmt.Errorf("unsupported device scheme %q in %q", u.Scheme, rawURL)
+	}
+}
+
+// parseHeaderFlags turns a repeated "Key: Value" flag into a header map,
+// skipping anything that doesn't contain a colon.
+func parseHeaderFlags(raw shared.RepeatableFlag) map[string]string {
+	headers := make(map[string]string, len(raw))
+	for _, entry := range raw {
+		key, value, ok := strings.Cut(entry, ":")
+		if !ok {
+			continue
+		}
+		headers[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return headers
+}
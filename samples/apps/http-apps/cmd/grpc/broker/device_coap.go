@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/plgd-dev/go-coap/v2/udp"
+)
+
+// coapDeviceClient speaks CoAP (RFC 7252) to constrained devices, dialing
+// fresh on every Read since these are low-duty-cycle sensors rather than
+// long-lived connections.
+type coapDeviceClient struct {
+	addr string
+}
+
+func newCoAPDeviceClient(u *url.URL) (*coapDeviceClient, error) {
+	if u.Host == "" {
+		return nil, fmt.Errorf("coap device URL %q is missing a host", u.String())
+	}
+	return &coapDeviceClient{addr: u.Host}, nil
+}
+
+func (c *coapDeviceClient) Read(ctx context.Context, path string) ([]byte, string, error) {
+	if path == "" {
+		path = "/"
+	}
+
+	conn, err := udp.Dial(c.addr)
+	if err != nil {
+		return nil, "", fmt.Errorf("dialing coap device %s: %w", c.addr, err)
+	}
+	defer conn.Close()
+
+	resp, err := conn.Get(ctx, path)
+	if err != nil {
+		return nil, "", fmt.Errorf("coap GET %s%s: %w", c.addr, path, err)
+	}
+
+	body, err := resp.ReadBody()
+	if err != nil {
+		return nil, "", err
+	}
+
+	contentFormat, err := resp.Options().ContentFormat()
+	if err != nil {
+		return body, "", nil
+	}
+
+	return body, contentFormat.String(), nil
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+// httpDeviceClient is the original http(s):// DeviceClient: one request per
+// Read, against baseURL+path.
+type httpDeviceClient struct {
+	httpClient *http.Client
+	baseURL    string
+	method     string
+	headers    map[string]string
+	accept     string
+}
+
+func newHTTPDeviceClient(baseURL string, httpClient *http.Client, opts deviceClientOptions) *httpDeviceClient {
+	method := opts.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	return &httpDeviceClient{
+		httpClient: httpClient,
+		baseURL:    baseURL,
+		method:     method,
+		headers:    opts.Headers,
+		accept:     opts.Accept,
+	}
+}
+
+func (c *httpDeviceClient) Read(ctx context.Context, path string) ([]byte, string, error) {
+	req, err := http.NewRequestWithContext(ctx, c.method, c.baseURL+path, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	for key, value := range c.headers {
+		req.Header.Set(key, value)
+	}
+	if c.accept != "" {
+		req.Header.Set("Accept", c.accept)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, "", fmt.Errorf("response code: %d", resp.StatusCode)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body, resp.Header.Get("Content-Type"), nil
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"net/http"
+
+	pb "github.com/deislabs/akri/http-extensibility/protos"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+)
+
+// openapiSpecPath is where the Dockerfile places the OpenAPI v2 document
+// generated from protos/http.proto by protoc-gen-openapiv2; it's served
+// as-is at /swagger.json.
+const openapiSpecPath = "protos/http.swagger.json"
+
+// serveGateway starts an HTTP/JSON bridge in front of grpcEndpoint, built
+// with grpc-gateway from the google.api.http annotations on DeviceService.
+// It runs until ctx is cancelled.
+func serveGateway(ctx context.Context, httpEndpoint, grpcEndpoint string, dialOpts []grpc.DialOption) error {
+	mux := runtime.NewServeMux()
+	if err := pb.RegisterDeviceServiceHandlerFromEndpoint(ctx, mux, grpcEndpoint, dialOpts); err != nil {
+		return err
+	}
+
+	top := http.NewServeMux()
+	top.Handle("/", mux)
+	top.HandleFunc("/swagger.json", func(w http.ResponseWriter, r *http.Request) {
+		http.ServeFile(w, r, openapiSpecPath)
+	})
+
+	server := &http.Server{
+		Addr:    httpEndpoint,
+		Handler: top,
+	}
+
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+
+	sugar.Infof("[main] Starting HTTP/JSON gateway [%s]", httpEndpoint)
+	return server.ListenAndServe()
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// deviceProbe tracks the result of the last probe_failure_threshold
+// background probes of the device, and is what backs both the gRPC health
+// service and GetDeviceStatus.
+type deviceProbe struct {
+	mu                  sync.RWMutex
+	healthy             bool
+	consecutiveFailures int32
+}
+
+func newDeviceProbe() *deviceProbe {
+	return &deviceProbe{healthy: true}
+}
+
+func (p *deviceProbe) recordResult(ok bool, failureThreshold int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if ok {
+		p.consecutiveFailures = 0
+		p.healthy = true
+		return
+	}
+
+	p.consecutiveFailures++
+	if int(p.consecutiveFailures) >= failureThreshold {
+		p.healthy = false
+	}
+}
+
+func (p *deviceProbe) snapshot() (healthy bool, consecutiveFailures int32) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.healthy, p.consecutiveFailures
+}
+
+// runDeviceProbe reads the device via client every interval, updates probe,
+// and mirrors the result onto healthServer until ctx is cancelled.
+func runDeviceProbe(ctx context.Context, client DeviceClient, interval time.Duration, failureThreshold int, probe *deviceProbe, healthServer *health.Server) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, _, err := client.Read(ctx, "")
+			probe.recordResult(err == nil, failureThreshold)
+
+			healthy, failures := probe.snapshot()
+			if err != nil {
+				sugar.Warnf("[device_probe] Failed (%d consecutive): %v", failures, err)
+			}
+
+			status := grpc_health_v1.HealthCheckResponse_NOT_SERVING
+			if healthy {
+				status = grpc_health_v1.HealthCheckResponse_SERVING
+			}
+			healthServer.SetServingStatus("", status)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"github.com/deislabs/akri/http-extensibility/shared"
+
+	"go.uber.org/zap"
+)
+
+// logger is the broker's structured logger; sugar wraps it for the
+// printf-style call sites the rest of this package uses.
+var (
+	logger *zap.Logger
+	sugar  *zap.SugaredLogger
+)
+
+func init() {
+	l, err := shared.NewLogger()
+	if err != nil {
+		panic(err)
+	}
+	logger = l
+	sugar = l.Sugar()
+}
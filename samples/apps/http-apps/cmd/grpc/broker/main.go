@@ -1,46 +1,176 @@
 package main
 
 import (
+	"context"
 	"flag"
-	"log"
 	"net"
+	"net/http"
 	"os"
+	"time"
 
-	pb "github.com/deislabs/akri/http-extensibility/prots"
+	pb "github.com/deislabs/akri/http-extensibility/protos"
 
+	"github.com/deislabs/akri/http-extensibility/shared"
+
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 )
 
 const (
-	deviceEndpoint = "AKRI_HTTP_DEVICE_ENDPOINT"
+	// deviceEndpoint is checked first; legacyDeviceEndpoint is the
+	// pre-multi-protocol name and is used as a fallback so existing
+	// deployments keep working unchanged.
+	deviceEndpoint       = "AKRI_DEVICE_ENDPOINT"
+	legacyDeviceEndpoint = "AKRI_HTTP_DEVICE_ENDPOINT"
 )
 
 var (
-	grpcEndpoint = flag.String("grpc_endpoint", "", "The endpoint of this gRPC server.")
+	grpcEndpoint    = flag.String("grpc_endpoint", "", "The endpoint of this gRPC server.")
+	httpEndpoint    = flag.String("http_endpoint", "", "If set, also serve DeviceService as HTTP/JSON on this endpoint via grpc-gateway.")
+	metricsEndpoint = flag.String("metrics_endpoint", "", "If set, serve Prometheus metrics on this endpoint.")
+
+	tlsCertFile       = flag.String("tls_cert", "", "Path to the TLS certificate used by the gRPC server")
+	tlsKeyFile        = flag.String("tls_key", "", "Path to the TLS private key used by the gRPC server")
+	tlsCAFile         = flag.String("tls_ca", "", "Path to a CA bundle used to verify client certificates")
+	requireClientCert = flag.Bool("require_client_cert", false, "Require and verify a client certificate (mutual TLS)")
+
+	deviceCAFile         = flag.String("device_ca", "", "Path to a CA bundle used to verify the device's TLS certificate")
+	deviceClientCertFile = flag.String("device_client_cert", "", "Path to the client certificate used to authenticate to the device")
+	deviceClientKeyFile  = flag.String("device_client_key", "", "Path to the client private key used to authenticate to the device")
+	deviceServerName     = flag.String("device_server_name", "", "Overrides the server name used to verify the device's TLS certificate")
+
+	brokerTokenFile = flag.String("broker_token_file", "", "Path to a file containing the bearer token required on incoming RPCs; defaults to "+brokerTokenEnv)
+
+	enableReflection      = flag.Bool("enable_reflection", false, "Register gRPC server reflection, for introspection with grpcurl")
+	probeInterval         = flag.Duration("probe_interval", 10*time.Second, "How often to probe the device for the gRPC health service and GetDeviceStatus")
+	probeFailureThreshold = flag.Int("probe_failure_threshold", 3, "Consecutive failed probes before the device is reported NOT_SERVING")
+
+	deviceMethod = flag.String("device_method", http.MethodGet, "HTTP method used to poll http(s) devices")
+	deviceAccept = flag.String("accept", "", "Accept header sent to http(s) devices")
 )
 
+var _ flag.Value = (*shared.RepeatableFlag)(nil)
+var deviceHeaders shared.RepeatableFlag
+
 func main() {
-	log.Println("[main] Starting gRPC server")
+	defer logger.Sync()
+	sugar.Info("[main] Starting gRPC server")
 
+	flag.Var(&deviceHeaders, "device_header", "Repeat this flag to add extra headers (Key: Value) sent to http(s) devices")
 	flag.Parse()
 	if *grpcEndpoint == "" {
-		log.Fatal("[main] Unable to start server. Requires gRPC endpoint.")
+		sugar.Fatal("[main] Unable to start server. Requires gRPC endpoint.")
 	}
 
 	deviceURL := os.Getenv(deviceEndpoint)
 	if deviceURL == "" {
-		log.Fatalf("Unable to determine Device URL using environment: %s", deviceEndpoint)
+		deviceURL = os.Getenv(legacyDeviceEndpoint)
+	}
+	if deviceURL == "" {
+		sugar.Fatalf("Unable to determine Device URL using environment: %s (or legacy %s)", deviceEndpoint, legacyDeviceEndpoint)
 	}
 
 	serverOpts := []grpc.ServerOption{}
+
+	if *tlsCertFile != "" {
+		tlsConfig, err := newServerTLSConfig(*tlsCertFile, *tlsKeyFile, *tlsCAFile, *requireClientCert)
+		if err != nil {
+			sugar.Fatalf("[main] Unable to configure TLS: %v", err)
+		}
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	} else if *requireClientCert {
+		sugar.Fatal("[main] require_client_cert set without tls_cert")
+	}
+
+	unaryInterceptors := []grpc.UnaryServerInterceptor{grpc_prometheus.UnaryServerInterceptor}
+	streamInterceptors := []grpc.StreamServerInterceptor{grpc_prometheus.StreamServerInterceptor}
+	token, err := loadBrokerToken(*brokerTokenFile)
+	if err != nil {
+		sugar.Fatalf("[main] Unable to load broker token: %v", err)
+	}
+	if token != "" {
+		if *tlsCertFile == "" {
+			sugar.Fatal("[main] broker token configured without tls_cert; refusing to accept it over a plaintext listener")
+		}
+		unaryInterceptors = append(unaryInterceptors, newTokenAuthInterceptor(token))
+		streamInterceptors = append(streamInterceptors, newStreamTokenAuthInterceptor(token))
+	}
+	serverOpts = append(serverOpts,
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
+
 	grpcServer := grpc.NewServer(serverOpts...)
 
-	pb.RegisterDeviceServiceServer(grpcServer, NewServer(deviceURL))
+	httpClient := http.DefaultClient
+	if *deviceCAFile != "" || *deviceClientCertFile != "" || *deviceServerName != "" {
+		deviceTLSConfig, err := newDeviceTLSConfig(*deviceCAFile, *deviceClientCertFile, *deviceClientKeyFile, *deviceServerName)
+		if err != nil {
+			sugar.Fatalf("[main] Unable to configure device TLS: %v", err)
+		}
+		httpClient = &http.Client{
+			Transport: &http.Transport{TLSClientConfig: deviceTLSConfig},
+		}
+	}
+
+	deviceClient, err := newDeviceClient(deviceURL, httpClient, deviceClientOptions{
+		Method:  *deviceMethod,
+		Accept:  *deviceAccept,
+		Headers: parseHeaderFlags(deviceHeaders),
+	})
+	if err != nil {
+		sugar.Fatalf("[main] Unable to configure device client: %v", err)
+	}
+
+	probe := newDeviceProbe()
+	healthServer := health.NewServer()
+	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
+
+	probeCtx, cancelProbe := context.WithCancel(context.Background())
+	defer cancelProbe()
+	go runDeviceProbe(probeCtx, deviceClient, *probeInterval, *probeFailureThreshold, probe, healthServer)
+
+	if *enableReflection {
+		reflection.Register(grpcServer)
+	}
+
+	pb.RegisterDeviceServiceServer(grpcServer, NewServer(deviceURL, deviceClient, probe))
+	grpc_prometheus.Register(grpcServer)
+
+	if *metricsEndpoint != "" {
+		go func() {
+			if err := serveMetrics(context.Background(), *metricsEndpoint); err != nil {
+				sugar.Fatalf("[main] Metrics server failed: %v", err)
+			}
+		}()
+	}
+
+	if *httpEndpoint != "" {
+		gatewayDialOpts, err := newGatewayDialOpts(*tlsCertFile, *tlsKeyFile, *requireClientCert)
+		if err != nil {
+			sugar.Fatalf("[main] Unable to configure gateway loopback dial: %v", err)
+		}
+		if token != "" {
+			gatewayDialOpts = append(gatewayDialOpts, grpc.WithPerRPCCredentials(loopbackTokenCredentials{
+				token:      token,
+				requireTLS: *tlsCertFile != "",
+			}))
+		}
+		go func() {
+			if err := serveGateway(context.Background(), *httpEndpoint, *grpcEndpoint, gatewayDialOpts); err != nil {
+				sugar.Fatalf("[main] Gateway server failed: %v", err)
+			}
+		}()
+	}
 
 	listen, err := net.Listen("tcp", *grpcEndpoint)
 	if err != nil {
-		log.Fatal(err)
+		sugar.Fatal(err)
 	}
-	log.Printf("[main] Starting gRPC Listener [%s]\n", *grpcEndpoint)
-	log.Fatal(grpcServer.Serve(listen))
+	sugar.Infof("[main] Starting gRPC Listener [%s]", *grpcEndpoint)
+	sugar.Fatal(grpcServer.Serve(listen))
 }
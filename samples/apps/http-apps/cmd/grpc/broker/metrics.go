@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// maxDeviceRequestPaths bounds how many distinct "path" label values
+// deviceRequestDuration will track. Path is rqst.Name, supplied by the RPC
+// caller rather than constrained to the device's own path set, so without
+// a cap a buggy or malicious caller could blow up the histogram's
+// cardinality; paths past the cap are recorded under otherPathLabel.
+const maxDeviceRequestPaths = 64
+
+const otherPathLabel = "other"
+
+// deviceRequestDuration is akri_http_device_request_duration_seconds from
+// the request: broker-side latency of a single device read, by path and
+// outcome. Path values beyond maxDeviceRequestPaths collapse to
+// otherPathLabel; see seenDeviceRequestPaths.
+var deviceRequestDuration = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name: "akri_http_device_request_duration_seconds",
+		Help: "Latency of broker requests to the downstream device, by path and status.",
+	},
+	[]string{"path", "status"},
+)
+
+var (
+	seenDeviceRequestPathsMu sync.Mutex
+	seenDeviceRequestPaths   = make(map[string]struct{}, maxDeviceRequestPaths)
+)
+
+func init() {
+	prometheus.MustRegister(deviceRequestDuration)
+}
+
+// observeDeviceRequest records one device read's latency and outcome.
+func observeDeviceRequest(path string, err error, elapsed time.Duration) {
+	status := "ok"
+	if err != nil {
+		status = "error"
+	}
+	deviceRequestDuration.WithLabelValues(boundedPathLabel(path), status).Observe(elapsed.Seconds())
+}
+
+// boundedPathLabel returns path unchanged once it (or maxDeviceRequestPaths
+// other distinct paths) has already been observed, and otherPathLabel
+// otherwise, so the metric's cardinality can't grow without bound.
+func boundedPathLabel(path string) string {
+	seenDeviceRequestPathsMu.Lock()
+	defer seenDeviceRequestPathsMu.Unlock()
+
+	if _, ok := seenDeviceRequestPaths[path]; !ok {
+		if len(seenDeviceRequestPaths) >= maxDeviceRequestPaths {
+			return otherPathLabel
+		}
+		seenDeviceRequestPaths[path] = struct{}{}
+	}
+	return path
+}
+
+// serveMetrics starts an http.Server exposing Prometheus metrics -- the
+// gRPC server metrics registered via grpc_prometheus plus the broker's own
+// -- until ctx is cancelled.
+func serveMetrics(ctx context.Context, metricsEndpoint string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	server := &http.Server{
+		Addr:    metricsEndpoint,
+		Handler: mux,
+	}
+
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+
+	sugar.Infof("[main] Starting metrics server [%s]", metricsEndpoint)
+	return server.ListenAndServe()
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestBoundedPathLabel(t *testing.T) {
+	seenDeviceRequestPathsMu.Lock()
+	seenDeviceRequestPaths = make(map[string]struct{}, maxDeviceRequestPaths)
+	seenDeviceRequestPathsMu.Unlock()
+
+	for i := 0; i < maxDeviceRequestPaths; i++ {
+		path := "/path" + strconv.Itoa(i)
+		if got := boundedPathLabel(path); got != path {
+			t.Fatalf("boundedPathLabel(%q) = %q before the cap, want %q", path, got, path)
+		}
+	}
+
+	if got := boundedPathLabel("one-too-many"); got != otherPathLabel {
+		t.Errorf("boundedPathLabel() past the cap = %q, want %q", got, otherPathLabel)
+	}
+
+	// An already-seen path still returns itself even once the cap is hit.
+	if got := boundedPathLabel("/path0"); got != "/path0" {
+		t.Errorf("boundedPathLabel() for an already-seen path = %q, want %q", got, "/path0")
+	}
+}
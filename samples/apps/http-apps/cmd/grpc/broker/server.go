@@ -2,48 +2,80 @@ package main
 
 import (
 	"context"
-	"fmt"
-	"io/ioutil"
-	"log"
-	"net/http"
+	"time"
 
 	pb "github.com/deislabs/akri/http-extensibility/protos"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var _ pb.DeviceServiceServer = (*Server)(nil)
 
+// tracer spans the broker's outbound calls to the device, so they can be
+// correlated with the gRPC RPC that triggered them.
+var tracer = otel.Tracer("github.com/deislabs/akri/http-extensibility/broker")
+
 // Server is a type that implements pb.DeviceServiceServer
 type Server struct {
+	pb.UnimplementedDeviceServiceServer
+
 	DeviceURL string
+
+	// client is the protocol-specific DeviceClient dispatched in main.go
+	// from DeviceURL's scheme (http(s)://, coap://, modbus+tcp://, ...).
+	client DeviceClient
+
+	// probe is the background health check started in main.go; it backs
+	// GetDeviceStatus. It is nil when probing is disabled, in which case
+	// GetDeviceStatus always reports healthy.
+	probe *deviceProbe
 }
 
 // NewServer is a function that returns a new Server
-func NewServer(deviceURL string) *Server {
+func NewServer(deviceURL string, client DeviceClient, probe *deviceProbe) *Server {
 	return &Server{
 		DeviceURL: deviceURL,
+		client:    client,
+		probe:     probe,
 	}
 }
 
+// GetDeviceStatus is a method that implements the pb.DeviceServiceServer
+// interface. It reports the broker's most recent background probe of its
+// downstream device.
+func (s *Server) GetDeviceStatus(ctx context.Context, rqst *pb.GetDeviceStatusRequest) (*pb.GetDeviceStatusResponse, error) {
+	if s.probe == nil {
+		return &pb.GetDeviceStatusResponse{Healthy: true}, nil
+	}
+	healthy, failures := s.probe.snapshot()
+	return &pb.GetDeviceStatusResponse{
+		Healthy:             healthy,
+		ConsecutiveFailures: failures,
+	}, nil
+}
+
 // ReadSensor is a method that implements the pb.HTTPServer interface
 func (s *Server) ReadSensor(ctx context.Context, rqst *pb.ReadSensorRequest) (*pb.ReadSensorResponse, error) {
-	log.Println("[read_sensor] Entered")
-	resp, err := http.Get(s.DeviceURL)
-	if err != nil {
-		return &pb.ReadSensorResponse{}, err
-	}
-	defer resp.Body.Close()
+	ctx, span := tracer.Start(ctx, "read_sensor", trace.WithAttributes(
+		attribute.String("device_url", s.DeviceURL),
+		attribute.String("path", rqst.Name),
+	))
+	defer span.End()
 
-	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		log.Printf("[read_sensor] Response status: %d", resp.StatusCode)
-		return &pb.ReadSensorResponse{}, fmt.Errorf("response code: %d", resp.StatusCode)
-	}
+	log := sugar.With("trace_id", span.SpanContext().TraceID(), "device_url", s.DeviceURL, "path", rqst.Name)
+	log.Info("[read_sensor] Entered")
 
-	body, err := ioutil.ReadAll(resp.Body)
+	start := time.Now()
+	body, _, err := s.client.Read(ctx, rqst.Name)
+	observeDeviceRequest(rqst.Name, err, time.Since(start))
 	if err != nil {
+		span.RecordError(err)
 		return &pb.ReadSensorResponse{}, err
 	}
 
-	log.Printf("[read_sensor] Response body: %s", body)
+	log.Infof("[read_sensor] Response body: %s", body)
 	return &pb.ReadSensorResponse{
 		Value: string(body),
 	}, nil
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/deislabs/akri/http-extensibility/protos"
+
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	minStreamIntervalMs     = 100
+	maxStreamIntervalMs     = 60000
+	defaultStreamIntervalMs = 1000
+
+	// readSensorsWorkerPoolSize bounds how many paths of a ReadSensors
+	// request are polled concurrently.
+	readSensorsWorkerPoolSize = 4
+
+	// maxReadSensorsNames bounds how many paths a single ReadSensors call
+	// may request, so a caller can't force unbounded goroutine creation by
+	// submitting an arbitrarily large Names list.
+	maxReadSensorsNames = 256
+)
+
+// clampStreamInterval resolves the interval requested by a StreamSensor
+// caller to one the server is willing to run at: 0 means "use the
+// server-side default", anything else is clamped to
+// [minStreamIntervalMs, maxStreamIntervalMs].
+func clampStreamInterval(ms int64) time.Duration {
+	switch {
+	case ms <= 0:
+		ms = defaultStreamIntervalMs
+	case ms < minStreamIntervalMs:
+		ms = minStreamIntervalMs
+	case ms > maxStreamIntervalMs:
+		ms = maxStreamIntervalMs
+	}
+	return time.Duration(ms) * time.Millisecond
+}
+
+// StreamSensor is a method that implements the pb.DeviceServiceServer
+// interface. It polls DeviceURL on the requested interval and pushes each
+// successful read to the caller until the stream's context is cancelled.
+func (s *Server) StreamSensor(rqst *pb.StreamSensorRequest, stream pb.DeviceService_StreamSensorServer) error {
+	sugar.Infof("[stream_sensor] Entered: %s", rqst.Name)
+	ctx := stream.Context()
+
+	ticker := time.NewTicker(clampStreamInterval(rqst.IntervalMs))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			body, err := s.readPath(ctx, rqst.Name)
+			if err != nil {
+				sugar.Warnf("[stream_sensor] Poll failed: %v", err)
+				continue
+			}
+			if err := stream.Send(&pb.ReadSensorResponse{Value: string(body)}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// ReadSensors is a method that implements the pb.DeviceServiceServer
+// interface. It polls every requested path concurrently, bounded by
+// readSensorsWorkerPoolSize, and reports a value or an error per path.
+func (s *Server) ReadSensors(ctx context.Context, rqst *pb.ReadSensorsRequest) (*pb.ReadSensorsResponse, error) {
+	sugar.Infof("[read_sensors] Entered: %d paths", len(rqst.Names))
+
+	if len(rqst.Names) > maxReadSensorsNames {
+		return nil, status.Errorf(codes.InvalidArgument, "requested %d names, exceeds maximum of %d", len(rqst.Names), maxReadSensorsNames)
+	}
+
+	results := make([]*pb.SensorResult, len(rqst.Names))
+	sem := make(chan struct{}, readSensorsWorkerPoolSize)
+
+	g, gCtx := errgroup.WithContext(ctx)
+	for i, name := range rqst.Names {
+		i, name := i, name
+		g.Go(func() error {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			result := &pb.SensorResult{Name: name}
+			body, err := s.readPath(gCtx, name)
+			if err != nil {
+				result.Error = err.Error()
+			} else {
+				result.Value = string(body)
+			}
+			results[i] = result
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return &pb.ReadSensorsResponse{Results: results}, nil
+}
+
+// readPath polls path on the broker's DeviceClient and returns its body.
+func (s *Server) readPath(ctx context.Context, path string) ([]byte, error) {
+	body, _, err := s.client.Read(ctx, path)
+	return body, err
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/deislabs/akri/http-extensibility/protos"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClampStreamInterval(t *testing.T) {
+	cases := []struct {
+		name string
+		ms   int64
+		want time.Duration
+	}{
+		{"zero uses default", 0, defaultStreamIntervalMs * time.Millisecond},
+		{"negative uses default", -5, defaultStreamIntervalMs * time.Millisecond},
+		{"below minimum clamps up", 1, minStreamIntervalMs * time.Millisecond},
+		{"above maximum clamps down", maxStreamIntervalMs + 1, maxStreamIntervalMs * time.Millisecond},
+		{"in range is unchanged", 500, 500 * time.Millisecond},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := clampStreamInterval(tc.ms); got != tc.want {
+				t.Errorf("clampStreamInterval(%d) = %v, want %v", tc.ms, got, tc.want)
+			}
+		})
+	}
+}
+
+// echoDeviceClient is a DeviceClient stub that reports the requested path
+// as its body, so ReadSensors results can be matched up to their request.
+type echoDeviceClient struct{}
+
+func (echoDeviceClient) Read(ctx context.Context, path string) ([]byte, string, error) {
+	return []byte(path), "", nil
+}
+
+func TestReadSensorsRejectsOversizedRequests(t *testing.T) {
+	s := NewServer("http://device", echoDeviceClient{}, nil)
+
+	names := make([]string, maxReadSensorsNames+1)
+	for i := range names {
+		names[i] = "/"
+	}
+
+	_, err := s.ReadSensors(context.Background(), &pb.ReadSensorsRequest{Names: names})
+	if err == nil {
+		t.Fatal("expected an error for a Names list over maxReadSensorsNames")
+	}
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("got code %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+}
+
+func TestReadSensorsWithinLimit(t *testing.T) {
+	s := NewServer("http://device", echoDeviceClient{}, nil)
+
+	resp, err := s.ReadSensors(context.Background(), &pb.ReadSensorsRequest{Names: []string{"/a", "/b"}})
+	if err != nil {
+		t.Fatalf("ReadSensors: %v", err)
+	}
+	if len(resp.Results) != 2 {
+		t.Fatalf("got %d results, want 2", len(resp.Results))
+	}
+	for i, name := range []string{"/a", "/b"} {
+		if resp.Results[i].Name != name || resp.Results[i].Value != name {
+			t.Errorf("result[%d] = %+v, want Name/Value %q", i, resp.Results[i], name)
+		}
+	}
+}
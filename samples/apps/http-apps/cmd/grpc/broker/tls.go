@@ -0,0 +1,108 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// newServerTLSConfig builds a *tls.Config for the broker's gRPC listener.
+// certFile/keyFile are required to enable TLS at all; caFile, when set, is
+// used to verify client certificates, and requireClientCert upgrades that
+// check from optional to mandatory (mutual TLS).
+func newServerTLSConfig(certFile, keyFile, caFile string, requireClientCert bool) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("loading server TLS key pair: %w", err)
+	}
+
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+	}
+
+	if caFile != "" {
+		caCert, err := ioutil.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading client CA bundle: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no certificates found in %s", caFile)
+		}
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+
+	if requireClientCert {
+		if cfg.ClientCAs == nil {
+			return nil, fmt.Errorf("require_client_cert set without tls_ca")
+		}
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return cfg, nil
+}
+
+// newDeviceTLSConfig builds a *tls.Config used when the broker polls an
+// HTTPS device. caFile verifies the device's certificate; certFile/keyFile,
+// when both set, present a client certificate to the device; serverName
+// overrides the name used for certificate verification (useful when
+// DeviceURL is reached through a Kubernetes Service name that doesn't match
+// the certificate's SAN).
+func newDeviceTLSConfig(caFile, certFile, keyFile, serverName string) (*tls.Config, error) {
+	cfg := &tls.Config{}
+
+	if caFile != "" {
+		caCert, err := ioutil.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading device CA bundle: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no certificates found in %s", caFile)
+		}
+		cfg.RootCAs = pool
+	}
+
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("loading device client TLS key pair: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	if serverName != "" {
+		cfg.ServerName = serverName
+	}
+
+	return cfg, nil
+}
+
+// newGatewayDialOpts builds the grpc.DialOption set the grpc-gateway uses
+// to reach the broker's own gRPC listener over loopback. When TLS is
+// enabled it trusts whatever certificate the server just presented; when
+// requireClientCert is also set, it presents the broker's own server
+// certificate back as its client certificate, since that's the only
+// certificate this loopback dial could plausibly use to satisfy the
+// server's mutual TLS requirement.
+func newGatewayDialOpts(certFile, keyFile string, requireClientCert bool) ([]grpc.DialOption, error) {
+	if certFile == "" {
+		return []grpc.DialOption{grpc.WithInsecure()}, nil
+	}
+
+	tlsConfig := &tls.Config{InsecureSkipVerify: true}
+	if requireClientCert {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("loading gateway client TLS key pair: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))}, nil
+}
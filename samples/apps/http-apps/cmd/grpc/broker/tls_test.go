@@ -0,0 +1,105 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeTestKeyPair generates a self-signed cert/key pair under dir and
+// returns their paths, for tests that only care about TLS config plumbing.
+func writeTestKeyPair(t *testing.T, dir string) (certFile, keyFile string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("creating certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshaling key: %v", err)
+	}
+
+	certFile = filepath.Join(dir, "cert.pem")
+	keyFile = filepath.Join(dir, "key.pem")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return certFile, keyFile
+}
+
+func TestNewServerTLSConfigRequireClientCertWithoutCA(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeTestKeyPair(t, dir)
+
+	if _, err := newServerTLSConfig(certFile, keyFile, "", true); err == nil {
+		t.Error("expected an error when require_client_cert is set without tls_ca")
+	}
+}
+
+func TestNewServerTLSConfigOptional(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeTestKeyPair(t, dir)
+
+	cfg, err := newServerTLSConfig(certFile, keyFile, "", false)
+	if err != nil {
+		t.Fatalf("newServerTLSConfig: %v", err)
+	}
+	if cfg.ClientAuth != tls.NoClientCert {
+		t.Errorf("ClientAuth = %v, want NoClientCert", cfg.ClientAuth)
+	}
+}
+
+func TestNewGatewayDialOptsInsecure(t *testing.T) {
+	opts, err := newGatewayDialOpts("", "", false)
+	if err != nil {
+		t.Fatalf("newGatewayDialOpts: %v", err)
+	}
+	if len(opts) != 1 {
+		t.Fatalf("got %d dial options, want 1", len(opts))
+	}
+}
+
+func TestNewGatewayDialOptsRequireClientCertWithoutKeyPair(t *testing.T) {
+	dir := t.TempDir()
+	certFile, _ := writeTestKeyPair(t, dir)
+
+	if _, err := newGatewayDialOpts(certFile, filepath.Join(dir, "missing-key.pem"), true); err == nil {
+		t.Error("expected an error when the gateway's client key pair can't be loaded")
+	}
+}
+
+func TestNewGatewayDialOptsTLS(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeTestKeyPair(t, dir)
+
+	opts, err := newGatewayDialOpts(certFile, keyFile, true)
+	if err != nil {
+		t.Fatalf("newGatewayDialOpts: %v", err)
+	}
+	if len(opts) != 1 {
+		t.Fatalf("got %d dial options, want 1", len(opts))
+	}
+}
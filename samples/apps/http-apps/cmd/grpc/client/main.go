@@ -3,18 +3,58 @@ package main
 import (
 	"context"
 	"flag"
+	"io/ioutil"
 	"log"
+	"os"
+	"strings"
 	"time"
 
-	pb "github.com/deislabs/akri/http-extensibility/proto"
+	pb "github.com/deislabs/akri/http-extensibility/protos"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
+const brokerTokenEnv = "AKRI_BROKER_TOKEN"
+
 var (
 	grpcEndpoint = flag.String("grpc_endpoint", "", "The endpoint of the gRPC server.")
+
+	tlsCertFile     = flag.String("tls_cert", "", "Path to a client TLS certificate, for mutual TLS")
+	tlsKeyFile      = flag.String("tls_key", "", "Path to the client TLS private key, for mutual TLS")
+	tlsCAFile       = flag.String("tls_ca", "", "Path to a CA bundle used to verify the broker's certificate; enables TLS")
+	tlsServerName   = flag.String("tls_server_name", "", "Overrides the server name used to verify the broker's certificate")
+	brokerTokenFile = flag.String("broker_token_file", "", "Path to a file containing the bearer token to send with every RPC; defaults to "+brokerTokenEnv)
 )
 
+// staticTokenCredentials implements credentials.PerRPCCredentials with a
+// single bearer token, for brokers that authenticate with a pre-shared
+// token rather than a full OAuth flow. It always requires transport
+// security: a configured token must never go out over a plaintext dial,
+// regardless of whether TLS flags happen to be set elsewhere.
+type staticTokenCredentials struct {
+	token string
+}
+
+func (c staticTokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + c.token}, nil
+}
+
+func (c staticTokenCredentials) RequireTransportSecurity() bool {
+	return true
+}
+
+func loadBrokerToken(tokenFile string) (string, error) {
+	if tokenFile == "" {
+		return os.Getenv(brokerTokenEnv), nil
+	}
+	b, err := ioutil.ReadFile(tokenFile)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
 func main() {
 	log.Println("[main] Starting gRPC client")
 	defer func() {
@@ -26,9 +66,26 @@ func main() {
 		log.Fatal("[main] Unable to start client. Requires endpoint to a gRPC Server.")
 	}
 
-	dialOpts := []grpc.DialOption{
-		grpc.WithInsecure(),
+	useTLS := *tlsCAFile != "" || *tlsCertFile != ""
+	dialOpts := []grpc.DialOption{}
+	if useTLS {
+		tlsConfig, err := newClientTLSConfig(*tlsCAFile, *tlsCertFile, *tlsKeyFile, *tlsServerName)
+		if err != nil {
+			log.Fatalf("[main] Unable to configure TLS: %v", err)
+		}
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	} else {
+		dialOpts = append(dialOpts, grpc.WithInsecure())
 	}
+
+	token, err := loadBrokerToken(*brokerTokenFile)
+	if err != nil {
+		log.Fatalf("[main] Unable to load broker token: %v", err)
+	}
+	if token != "" {
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(staticTokenCredentials{token: token}))
+	}
+
 	log.Printf("Connecting to gRPC server [%s]", *grpcEndpoint)
 	conn, err := grpc.Dial(*grpcEndpoint, dialOpts...)
 	if err != nil {
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestStaticTokenCredentialsAlwaysRequiresTransportSecurity(t *testing.T) {
+	c := staticTokenCredentials{token: "secret"}
+	if !c.RequireTransportSecurity() {
+		t.Error("RequireTransportSecurity() = false, want true even with no TLS configured")
+	}
+
+	md, err := c.GetRequestMetadata(context.Background())
+	if err != nil {
+		t.Fatalf("GetRequestMetadata: %v", err)
+	}
+	if md["authorization"] != "Bearer secret" {
+		t.Errorf("got %q, want %q", md["authorization"], "Bearer secret")
+	}
+}
+
+func TestLoadBrokerToken(t *testing.T) {
+	t.Run("env", func(t *testing.T) {
+		os.Setenv(brokerTokenEnv, "from-env")
+		defer os.Unsetenv(brokerTokenEnv)
+
+		got, err := loadBrokerToken("")
+		if err != nil {
+			t.Fatalf("loadBrokerToken: %v", err)
+		}
+		if got != "from-env" {
+			t.Errorf("got %q, want %q", got, "from-env")
+		}
+	})
+
+	t.Run("file takes precedence over env", func(t *testing.T) {
+		os.Setenv(brokerTokenEnv, "from-env")
+		defer os.Unsetenv(brokerTokenEnv)
+
+		path := filepath.Join(t.TempDir(), "token")
+		if err := ioutil.WriteFile(path, []byte("from-file\n"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := loadBrokerToken(path)
+		if err != nil {
+			t.Fatalf("loadBrokerToken: %v", err)
+		}
+		if got != "from-file" {
+			t.Errorf("got %q, want %q", got, "from-file")
+		}
+	})
+}
@@ -0,0 +1,22 @@
+package shared
+
+import (
+	"os"
+
+	"go.uber.org/zap"
+)
+
+// akriLogDevEnv switches NewLogger to zap's human-readable development
+// encoder; unset (the default) gets the JSON production encoder that's
+// easier to ship to a log aggregator.
+const akriLogDevEnv = "AKRI_LOG_DEV"
+
+// NewLogger returns the structured logger shared by the http-apps
+// binaries. Call sites add their own fields (trace_id, device_url, path,
+// ...) via Logger.With rather than formatting them into the message.
+func NewLogger() (*zap.Logger, error) {
+	if os.Getenv(akriLogDevEnv) != "" {
+		return zap.NewDevelopment()
+	}
+	return zap.NewProduction()
+}